@@ -0,0 +1,57 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+func approleAuthBackendRoleIDDataSource() *schema.Resource {
+	return &schema.Resource{
+		Read: approleAuthBackendRoleIDDataSourceRead,
+
+		Schema: map[string]*schema.Schema{
+			"backend": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "approle",
+				Description: "Unique name of the auth backend to configure.",
+			},
+			"role_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the role.",
+			},
+			"role_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The RoleID of the role.",
+			},
+		},
+	}
+}
+
+func approleAuthBackendRoleIDDataSourceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	backend := d.Get("backend").(string)
+	role := d.Get("role_name").(string)
+
+	path := approleAuthBackendRolePath(backend, role) + "/role-id"
+
+	log.Printf("[DEBUG] Reading AppRole auth backend role %q RoleID", path)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error reading AppRole auth backend role %q RoleID: %s", path, err)
+	}
+	if resp == nil {
+		return fmt.Errorf("no role found at %q", path)
+	}
+
+	d.SetId(path)
+	d.Set("role_id", resp.Data["role_id"])
+
+	return nil
+}
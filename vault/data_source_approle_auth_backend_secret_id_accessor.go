@@ -0,0 +1,127 @@
+package vault
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+func approleAuthBackendSecretIDAccessorDataSource() *schema.Resource {
+	return &schema.Resource{
+		Read: approleAuthBackendSecretIDAccessorDataSourceRead,
+
+		Schema: map[string]*schema.Schema{
+			"backend": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "approle",
+				Description: "Unique name of the auth backend to configure.",
+			},
+			"role_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the role.",
+			},
+			"accessor": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Accessor of the SecretID.",
+			},
+			"cidr_list": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Description: "List of CIDR blocks enforced on the SecretID.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"metadata": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "Metadata tied to the SecretID.",
+			},
+			"creation_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Creation time of the SecretID.",
+			},
+			"expiration_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Expiration time of the SecretID.",
+			},
+			"last_updated_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Last time the SecretID was updated.",
+			},
+			"secret_id_num_uses": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Number of times this SecretID can still be used to fetch a token, before it expires.",
+			},
+		},
+	}
+}
+
+func approleAuthBackendSecretIDAccessorDataSourceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	backend := d.Get("backend").(string)
+	role := d.Get("role_name").(string)
+	accessor := d.Get("accessor").(string)
+
+	path := approleAuthBackendRolePath(backend, role) + "/secret-id-accessor/lookup"
+
+	log.Printf("[DEBUG] Looking up AppRole auth backend role %q SecretID accessor %q", path, accessor)
+	resp, err := client.Logical().Write(path, map[string]interface{}{
+		"secret_id_accessor": accessor,
+	})
+	if err != nil {
+		return fmt.Errorf("error looking up AppRole auth backend role %q SecretID accessor %q: %s", path, accessor, err)
+	}
+	if resp == nil {
+		return fmt.Errorf("no SecretID found for accessor %q at %q", accessor, path)
+	}
+
+	d.SetId(path + "/" + accessor)
+
+	var cidrs []string
+	switch value := resp.Data["cidr_list"].(type) {
+	case string:
+		if value != "" {
+			cidrs = strings.Split(value, ",")
+		}
+	case []interface{}:
+		for _, iCIDR := range value {
+			cidrs = append(cidrs, iCIDR.(string))
+		}
+	}
+	if err := d.Set("cidr_list", cidrs); err != nil {
+		return fmt.Errorf("error setting cidr_list in state: %s", err)
+	}
+
+	if metadataRaw, ok := resp.Data["metadata"]; ok {
+		if metadataMap, ok := metadataRaw.(map[string]interface{}); ok {
+			if err := d.Set("metadata", metadataMap); err != nil {
+				return fmt.Errorf("error setting metadata in state: %s", err)
+			}
+		}
+	}
+
+	d.Set("creation_time", resp.Data["creation_time"])
+	d.Set("expiration_time", resp.Data["expiration_time"])
+	d.Set("last_updated_time", resp.Data["last_updated_time"])
+
+	secretIDNumUses, err := resp.Data["secret_id_num_uses"].(json.Number).Int64()
+	if err != nil {
+		return fmt.Errorf("expected secret_id_num_uses %q to be a number, isn't", resp.Data["secret_id_num_uses"])
+	}
+	d.Set("secret_id_num_uses", secretIDNumUses)
+
+	return nil
+}
@@ -8,6 +8,7 @@ import (
 	"strings"
 
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/hashicorp/vault/api"
 	"github.com/terraform-providers/terraform-provider-vault/util"
 )
@@ -48,9 +49,12 @@ func approleAuthBackendRoleResource() *schema.Resource {
 				Description: "Whether or not to require secret_id to be present when logging in using this AppRole.",
 			},
 			"bound_cidr_list": {
-				Type:        schema.TypeSet,
-				Optional:    true,
-				Description: "List of CIDR blocks that can log in using the AppRole.",
+				Type:          schema.TypeSet,
+				Optional:      true,
+				Computed:      true,
+				Description:   "List of CIDR blocks that can log in using the AppRole.",
+				Deprecated:    "use `secret_id_bound_cidrs` and/or `token_bound_cidrs` instead",
+				ConflictsWith: []string{"token_bound_cidrs"},
 				Elem: &schema.Schema{
 					Type: schema.TypeString,
 				},
@@ -59,10 +63,13 @@ func approleAuthBackendRoleResource() *schema.Resource {
 			"policies": {
 				Type:     schema.TypeSet,
 				Optional: true,
+				Computed: true,
 				Elem: &schema.Schema{
 					Type: schema.TypeString,
 				},
-				Description: "Policies to be set on tokens issued using this AppRole.",
+				Description:   "Policies to be set on tokens issued using this AppRole.",
+				Deprecated:    "use `token_policies` instead",
+				ConflictsWith: []string{"token_policies"},
 			},
 
 			"secret_id_num_uses": {
@@ -75,6 +82,21 @@ func approleAuthBackendRoleResource() *schema.Resource {
 				Optional:    true,
 				Description: "Number of seconds a SecretID remains valid for.",
 			},
+			"secret_id_bound_cidrs": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Computed:    true,
+				Description: "List of CIDR blocks that SecretIDs generated using this role can be used from.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"local_secret_ids": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "If set, the secret IDs generated using this role will be cluster local. This can only be set during role creation and once set, can't be reset later.",
+			},
 			"token_num_uses": {
 				Type:        schema.TypeInt,
 				Optional:    true,
@@ -90,10 +112,61 @@ func approleAuthBackendRoleResource() *schema.Resource {
 				Optional:    true,
 				Description: "Number of seconds after which issued tokens can no longer be renewed.",
 			},
-			"period": {
+			"token_explicit_max_ttl": {
 				Type:        schema.TypeInt,
 				Optional:    true,
-				Description: "Number of seconds to set the TTL to for issued tokens upon renewal. Makes the token a periodic token, which will never expire as long as it is renewed before the TTL each period.",
+				Computed:    true,
+				Description: "Number of seconds after which issued tokens are no longer valid, no matter how much time passed since renewal.",
+			},
+			"token_no_default_policy": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				Description: "If set, the default policy will not be set on generated tokens; otherwise it will be added to the policies set in token_policies.",
+			},
+			"token_period": {
+				Type:          schema.TypeInt,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Number of seconds to set the TTL to for issued tokens upon renewal. Makes the token a periodic token, which will never expire as long as it is renewed before the TTL each period.",
+				ConflictsWith: []string{"period"},
+			},
+			"token_policies": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				Computed:      true,
+				Description:   "List of policies to encode onto generated tokens. Depending on the auth method, this list may be supplemented by user/group/other values.",
+				ConflictsWith: []string{"policies"},
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"token_bound_cidrs": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				Computed:      true,
+				Description:   "List of CIDR blocks; if set, specifies blocks of IP addresses which can authenticate successfully, and ties the resulting token to these blocks as well.",
+				ConflictsWith: []string{"bound_cidr_list"},
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"token_type": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The type of token that should be generated. Can be `service`, `batch`, or `default` to use the mount's tuned default (which unless changed will be `service` tokens). For token store roles, there are two additional possibilities: `default-service` and `default-batch` which specify the type to return unless the client requests a different type at generation time.",
+				ValidateFunc: validation.StringInSlice([]string{
+					"service", "batch", "default", "default-service", "default-batch",
+				}, false),
+			},
+			"period": {
+				Type:          schema.TypeInt,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Number of seconds to set the TTL to for issued tokens upon renewal. Makes the token a periodic token, which will never expire as long as it is renewed before the TTL each period.",
+				Deprecated:    "use `token_period` instead",
+				ConflictsWith: []string{"token_period"},
 			},
 			"backend": {
 				Type:        schema.TypeString,
@@ -119,28 +192,95 @@ func approleAuthBackendRoleCreate(d *schema.ResourceData, meta interface{}) erro
 	path := approleAuthBackendRolePath(backend, role)
 
 	log.Printf("[DEBUG] Writing AppRole auth backend role %q", path)
-	iPolicies := d.Get("policies").(*schema.Set).List()
-	policies := make([]string, 0, len(iPolicies))
-	for _, iPolicy := range iPolicies {
-		policies = append(policies, iPolicy.(string))
+
+	data := map[string]interface{}{}
+	approleAuthBackendRoleUpdateFields(d, data)
+
+	if v, ok := d.GetOk("local_secret_ids"); ok {
+		data["local_secret_ids"] = v.(bool)
 	}
 
-	iCIDRs := d.Get("bound_cidr_list").(*schema.Set).List()
-	cidrs := make([]string, 0, len(iCIDRs))
-	for _, iCIDR := range iCIDRs {
-		cidrs = append(cidrs, iCIDR.(string))
+	_, err := client.Logical().Write(path, data)
+	if err != nil {
+		return fmt.Errorf("error writing AppRole auth backend role %q: %s", path, err)
 	}
+	d.SetId(path)
+	log.Printf("[DEBUG] Wrote AppRole auth backend role %q", path)
 
-	data := map[string]interface{}{}
-	if v, ok := d.GetOk("period"); ok {
-		data["period"] = v.(int)
+	if v, ok := d.GetOk("role_id"); ok {
+		log.Printf("[DEBUG] Writing AppRole auth backend role %q RoleID", path)
+		_, err := client.Logical().Write(path+"/role-id", map[string]interface{}{
+			"role_id": v.(string),
+		})
+		if err != nil {
+			return fmt.Errorf("error writing AppRole auth backend role %q's RoleID: %s", path, err)
+		}
+		log.Printf("[DEBUG] Wrote AppRole auth backend role %q RoleID", path)
 	}
-	if len(policies) > 0 {
+
+	return approleAuthBackendRoleRead(d, meta)
+}
+
+// approleAuthBackendRoleUpdateFields populates data with the subset of the
+// role's schema that can be written both at create and update time.
+func approleAuthBackendRoleUpdateFields(d *schema.ResourceData, data map[string]interface{}) {
+	if v, ok := d.GetOk("policies"); ok {
+		iPolicies := v.(*schema.Set).List()
+		policies := make([]string, 0, len(iPolicies))
+		for _, iPolicy := range iPolicies {
+			policies = append(policies, iPolicy.(string))
+		}
 		data["policies"] = policies
 	}
-	if len(cidrs) > 0 {
+	if v, ok := d.GetOk("bound_cidr_list"); ok {
+		iCIDRs := v.(*schema.Set).List()
+		cidrs := make([]string, 0, len(iCIDRs))
+		for _, iCIDR := range iCIDRs {
+			cidrs = append(cidrs, iCIDR.(string))
+		}
 		data["bound_cidr_list"] = strings.Join(cidrs, ",")
 	}
+	if v, ok := d.GetOk("period"); ok {
+		data["period"] = v.(int)
+	}
+
+	if v, ok := d.GetOk("token_policies"); ok {
+		iPolicies := v.([]interface{})
+		policies := make([]string, 0, len(iPolicies))
+		for _, iPolicy := range iPolicies {
+			policies = append(policies, iPolicy.(string))
+		}
+		data["token_policies"] = policies
+	}
+	if v, ok := d.GetOk("token_bound_cidrs"); ok {
+		iCIDRs := v.([]interface{})
+		cidrs := make([]string, 0, len(iCIDRs))
+		for _, iCIDR := range iCIDRs {
+			cidrs = append(cidrs, iCIDR.(string))
+		}
+		data["token_bound_cidrs"] = cidrs
+	}
+	if v, ok := d.GetOk("token_explicit_max_ttl"); ok {
+		data["token_explicit_max_ttl"] = v.(int)
+	}
+	if v, ok := d.GetOkExists("token_no_default_policy"); ok {
+		data["token_no_default_policy"] = v.(bool)
+	}
+	if v, ok := d.GetOk("token_period"); ok {
+		data["token_period"] = v.(int)
+	}
+	if v, ok := d.GetOk("token_type"); ok {
+		data["token_type"] = v.(string)
+	}
+	if v, ok := d.GetOk("secret_id_bound_cidrs"); ok {
+		iCIDRs := v.(*schema.Set).List()
+		cidrs := make([]string, 0, len(iCIDRs))
+		for _, iCIDR := range iCIDRs {
+			cidrs = append(cidrs, iCIDR.(string))
+		}
+		data["secret_id_bound_cidrs"] = cidrs
+	}
+
 	if v, ok := d.GetOkExists("bind_secret_id"); ok {
 		data["bind_secret_id"] = v.(bool)
 	}
@@ -159,26 +299,6 @@ func approleAuthBackendRoleCreate(d *schema.ResourceData, meta interface{}) erro
 	if v, ok := d.GetOk("token_max_ttl"); ok {
 		data["token_max_ttl"] = v.(int)
 	}
-
-	_, err := client.Logical().Write(path, data)
-	if err != nil {
-		return fmt.Errorf("error writing AppRole auth backend role %q: %s", path, err)
-	}
-	d.SetId(path)
-	log.Printf("[DEBUG] Wrote AppRole auth backend role %q", path)
-
-	if v, ok := d.GetOk("role_id"); ok {
-		log.Printf("[DEBUG] Writing AppRole auth backend role %q RoleID", path)
-		_, err := client.Logical().Write(path+"/role-id", map[string]interface{}{
-			"role_id": v.(string),
-		})
-		if err != nil {
-			return fmt.Errorf("error writing AppRole auth backend role %q's RoleID: %s", path, err)
-		}
-		log.Printf("[DEBUG] Wrote AppRole auth backend role %q RoleID", path)
-	}
-
-	return approleAuthBackendRoleRead(d, meta)
 }
 
 func approleAuthBackendRoleRead(d *schema.ResourceData, meta interface{}) error {
@@ -274,6 +394,32 @@ func approleAuthBackendRoleRead(d *schema.ResourceData, meta interface{}) error
 	d.Set("token_max_ttl", tokenMaxTTL)
 	d.Set("period", period)
 	d.Set("bind_secret_id", resp.Data["bind_secret_id"])
+	d.Set("local_secret_ids", resp.Data["local_secret_ids"])
+
+	// The following fields are only present on newer (tokenutil-aware) Vault
+	// servers, so they're read defensively rather than asserted like the
+	// legacy fields above.
+	if v, ok := resp.Data["token_policies"]; ok {
+		d.Set("token_policies", v)
+	}
+	if v, ok := resp.Data["token_bound_cidrs"]; ok {
+		d.Set("token_bound_cidrs", v)
+	}
+	if v, ok := resp.Data["secret_id_bound_cidrs"]; ok {
+		d.Set("secret_id_bound_cidrs", v)
+	}
+	if v, ok := resp.Data["token_explicit_max_ttl"]; ok {
+		d.Set("token_explicit_max_ttl", v)
+	}
+	if v, ok := resp.Data["token_no_default_policy"]; ok {
+		d.Set("token_no_default_policy", v)
+	}
+	if v, ok := resp.Data["token_period"]; ok {
+		d.Set("token_period", v)
+	}
+	if v, ok := resp.Data["token_type"]; ok {
+		d.Set("token_type", v)
+	}
 
 	log.Printf("[DEBUG] Reading AppRole auth backend role %q RoleID", path)
 	resp, err = client.Logical().Read(path + "/role-id")
@@ -293,29 +439,29 @@ func approleAuthBackendRoleUpdate(d *schema.ResourceData, meta interface{}) erro
 	path := d.Id()
 
 	log.Printf("[DEBUG] Updating AppRole auth backend role %q", path)
-	iPolicies := d.Get("policies").(*schema.Set).List()
-	policies := make([]string, 0, len(iPolicies))
-	for _, iPolicy := range iPolicies {
-		policies = append(policies, iPolicy.(string))
-	}
-
-	iCIDRs := d.Get("bound_cidr_list").(*schema.Set).List()
-	cidrs := make([]string, 0, len(iCIDRs))
-	for _, iCIDR := range iCIDRs {
-		cidrs = append(cidrs, iCIDR.(string))
-	}
 
+	// These are always sent, even when zero, so that removing a value from
+	// config reliably clears it on the role rather than leaving Vault's
+	// last-written value in place (GetOk below only overrides when the
+	// corresponding field is actually set in config).
 	data := map[string]interface{}{
-		"policies":           policies,
-		"bound_cidr_list":    strings.Join(cidrs, ","),
-		"bind_secret_id":     d.Get("bind_secret_id").(bool),
-		"secret_id_num_uses": d.Get("secret_id_num_uses").(int),
-		"secret_id_ttl":      d.Get("secret_id_ttl").(int),
-		"token_num_uses":     d.Get("token_num_uses").(int),
-		"token_ttl":          d.Get("token_ttl").(int),
-		"token_max_ttl":      d.Get("token_max_ttl").(int),
-		"period":             d.Get("period").(int),
-	}
+		"policies":               []string{},
+		"bound_cidr_list":        "",
+		"bind_secret_id":         d.Get("bind_secret_id").(bool),
+		"secret_id_num_uses":     d.Get("secret_id_num_uses").(int),
+		"secret_id_ttl":          d.Get("secret_id_ttl").(int),
+		"secret_id_bound_cidrs":  []string{},
+		"token_num_uses":         d.Get("token_num_uses").(int),
+		"token_ttl":              d.Get("token_ttl").(int),
+		"token_max_ttl":          d.Get("token_max_ttl").(int),
+		"period":                 0,
+		"token_period":           0,
+		"token_type":             "",
+		"token_explicit_max_ttl": 0,
+		"token_policies":         []string{},
+		"token_bound_cidrs":      []string{},
+	}
+	approleAuthBackendRoleUpdateFields(d, data)
 
 	_, err := client.Logical().Write(path, data)
 
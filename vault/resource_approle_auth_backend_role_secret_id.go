@@ -0,0 +1,330 @@
+package vault
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/vault/api"
+	"github.com/terraform-providers/terraform-provider-vault/util"
+)
+
+var (
+	approleAuthBackendRoleSecretIDBackendFromPathRegex = regexp.MustCompile("^auth/(.+)/role/.+/secret-id$")
+	approleAuthBackendRoleSecretIDRoleFromPathRegex    = regexp.MustCompile("^auth/.+/role/(.+)/secret-id$")
+)
+
+func approleAuthBackendRoleSecretIDResource() *schema.Resource {
+	return &schema.Resource{
+		Create: approleAuthBackendRoleSecretIDCreate,
+		Read:   approleAuthBackendRoleSecretIDRead,
+		Delete: approleAuthBackendRoleSecretIDDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"backend": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     "approle",
+				Description: "Unique name of the auth backend to configure.",
+				// standardise on no beginning or trailing slashes
+				StateFunc: func(v interface{}) string {
+					return strings.Trim(v.(string), "/")
+				},
+			},
+			"role_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the role to create the SecretID for.",
+			},
+			"secret_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Sensitive:   true,
+				Description: "The SecretID to be created. If set, uses the 'custom-secret-id' endpoint so that the SecretID is set to the specified value.",
+			},
+			"cidr_list": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "List of CIDR blocks enforced on the SecretID.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"metadata": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Metadata key-value pairs to be tied to the SecretID. The map is sent to Vault as a JSON-encoded string.",
+			},
+			"wrapping_ttl": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "If set, the SecretID response will be wrapped using Vault's response wrapping feature and will have the TTL specified.",
+			},
+			"accessor": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Accessor of the SecretID; holds the same value as secret_id_accessor.",
+			},
+			"secret_id_accessor": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Accessor of the SecretID. Not available when wrapping_ttl is set, since reading it would require consuming the wrapping token.",
+			},
+			"wrapping_token": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The token used to retrieve the wrapped SecretID, if wrapping_ttl was set.",
+			},
+			"wrapping_accessor": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The accessor of the wrapping token, if wrapping_ttl was set.",
+			},
+			"wrapping_token_ttl": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of seconds the wrapping token returned in wrapping_token remains valid for, if wrapping_ttl was set.",
+			},
+		},
+	}
+}
+
+func approleAuthBackendRoleSecretIDCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	backend := d.Get("backend").(string)
+	role := d.Get("role_name").(string)
+
+	data := map[string]interface{}{}
+
+	iCIDRs := d.Get("cidr_list").(*schema.Set).List()
+	cidrs := make([]string, 0, len(iCIDRs))
+	for _, iCIDR := range iCIDRs {
+		cidrs = append(cidrs, iCIDR.(string))
+	}
+	if len(cidrs) > 0 {
+		data["cidr_list"] = strings.Join(cidrs, ",")
+	}
+
+	if v, ok := d.GetOk("metadata"); ok {
+		metadata, err := json.Marshal(v.(map[string]interface{}))
+		if err != nil {
+			return fmt.Errorf("error marshaling metadata to JSON: %s", err)
+		}
+		data["metadata"] = string(metadata)
+	}
+
+	secretID, hasSecretID := d.GetOk("secret_id")
+	if hasSecretID {
+		data["secret_id"] = secretID.(string)
+	}
+
+	var path string
+	if hasSecretID {
+		path = approleAuthBackendRolePath(backend, role) + "/custom-secret-id"
+	} else {
+		path = approleAuthBackendRolePath(backend, role) + "/secret-id"
+	}
+
+	if v, ok := d.GetOk("wrapping_ttl"); ok {
+		wrappingTTL := v.(string)
+		client.SetWrappingLookupFunc(func(operation, path string) string {
+			return wrappingTTL
+		})
+		defer client.SetWrappingLookupFunc(nil)
+	}
+
+	log.Printf("[DEBUG] Writing AppRole auth backend role %q SecretID", path)
+	resp, err := client.Logical().Write(path, data)
+	if err != nil {
+		return fmt.Errorf("error writing AppRole auth backend role %q SecretID: %s", path, err)
+	}
+	log.Printf("[DEBUG] Wrote AppRole auth backend role %q SecretID", path)
+
+	if resp == nil {
+		return fmt.Errorf("no response returned when writing AppRole auth backend role %q SecretID", path)
+	}
+
+	if resp.WrapInfo != nil {
+		// The wrapping token is single-use: unwrapping it here to learn the
+		// SecretID's accessor would consume the very token we're about to
+		// hand back to the caller, defeating the point of wrapping_ttl. So
+		// the accessor/secret_id_accessor/secret_id are left unset, and the
+		// resource is keyed off the wrapping token's own accessor instead.
+		d.Set("wrapping_token", resp.WrapInfo.Token)
+		d.Set("wrapping_accessor", resp.WrapInfo.Accessor)
+		d.Set("wrapping_token_ttl", resp.WrapInfo.TTL)
+		d.SetId(approleAuthBackendRolePath(backend, role) + "/secret-id/wrapped/" + resp.WrapInfo.Accessor)
+	} else {
+		d.Set("accessor", resp.Data["secret_id_accessor"])
+		d.Set("secret_id_accessor", resp.Data["secret_id_accessor"])
+		if !hasSecretID {
+			d.Set("secret_id", resp.Data["secret_id"])
+		}
+		d.SetId(approleAuthBackendRolePath(backend, role) + "/secret-id/" + resp.Data["secret_id_accessor"].(string))
+	}
+
+	return approleAuthBackendRoleSecretIDRead(d, meta)
+}
+
+func approleAuthBackendRoleSecretIDRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+	id := d.Id()
+
+	if approleAuthBackendRoleSecretIDIsWrapped(id) {
+		backend, role, wrappingAccessor, err := approleAuthBackendRoleSecretIDParseWrappedID(id)
+		if err != nil {
+			return fmt.Errorf("invalid id %q for AppRole auth backend role SecretID: %s", id, err)
+		}
+		log.Printf("[DEBUG] AppRole auth backend role SecretID %q was created with wrapping_ttl; its SecretID accessor is unavailable without consuming the wrapping token, so its state can't be refreshed", id)
+		d.Set("backend", backend)
+		d.Set("role_name", role)
+		d.Set("wrapping_accessor", wrappingAccessor)
+		return nil
+	}
+
+	backend, role, accessor, err := approleAuthBackendRoleSecretIDParseID(id)
+	if err != nil {
+		return fmt.Errorf("invalid id %q for AppRole auth backend role SecretID: %s", id, err)
+	}
+
+	path := approleAuthBackendRolePath(backend, role) + "/secret-id-accessor/lookup"
+
+	log.Printf("[DEBUG] Reading AppRole auth backend role SecretID %q", id)
+	resp, err := client.Logical().Write(path, map[string]interface{}{
+		"secret_id_accessor": accessor,
+	})
+	if err != nil {
+		return fmt.Errorf("error reading AppRole auth backend role SecretID %q: %s", id, err)
+	}
+	log.Printf("[DEBUG] Read AppRole auth backend role SecretID %q", id)
+	if resp == nil {
+		log.Printf("[WARN] AppRole auth backend role SecretID %q not found, removing from state", id)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("backend", backend)
+	d.Set("role_name", role)
+	d.Set("accessor", accessor)
+	d.Set("secret_id_accessor", accessor)
+
+	var cidrs []string
+	switch value := resp.Data["cidr_list"].(type) {
+	case string:
+		if value != "" {
+			cidrs = strings.Split(value, ",")
+		}
+	case []interface{}:
+		for _, iCIDR := range value {
+			cidrs = append(cidrs, iCIDR.(string))
+		}
+	}
+	if err := d.Set("cidr_list", cidrs); err != nil {
+		return fmt.Errorf("error setting cidr_list in state: %s", err)
+	}
+
+	if metadataRaw, ok := resp.Data["metadata"]; ok {
+		if metadataMap, ok := metadataRaw.(map[string]interface{}); ok {
+			if err := d.Set("metadata", metadataMap); err != nil {
+				return fmt.Errorf("error setting metadata in state: %s", err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func approleAuthBackendRoleSecretIDDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+	id := d.Id()
+
+	if approleAuthBackendRoleSecretIDIsWrapped(id) {
+		log.Printf("[WARN] AppRole auth backend role SecretID %q was created with wrapping_ttl; its SecretID accessor was never known to Terraform, so the underlying SecretID can't be destroyed and is only being removed from state", id)
+		d.SetId("")
+		return nil
+	}
+
+	backend, role, accessor, err := approleAuthBackendRoleSecretIDParseID(id)
+	if err != nil {
+		return fmt.Errorf("invalid id %q for AppRole auth backend role SecretID: %s", id, err)
+	}
+
+	path := approleAuthBackendRolePath(backend, role) + "/secret-id-accessor/destroy"
+
+	log.Printf("[DEBUG] Deleting AppRole auth backend role SecretID %q", id)
+	_, err = client.Logical().Write(path, map[string]interface{}{
+		"secret_id_accessor": accessor,
+	})
+	if err != nil && !util.Is404(err) {
+		return fmt.Errorf("error deleting AppRole auth backend role SecretID %q", id)
+	} else if err != nil {
+		log.Printf("[DEBUG] AppRole auth backend role SecretID %q not found, removing from state", id)
+	}
+	d.SetId("")
+	log.Printf("[DEBUG] Deleted AppRole auth backend role SecretID %q", id)
+
+	return nil
+}
+
+// approleAuthBackendRoleSecretIDWrappedMarker is the path segment used in
+// place of a SecretID accessor when the SecretID was issued wrapped, since
+// the real accessor can only be learned by consuming the wrapping token.
+const approleAuthBackendRoleSecretIDWrappedMarker = "/secret-id/wrapped/"
+
+func approleAuthBackendRoleSecretIDIsWrapped(id string) bool {
+	return strings.Contains(id, approleAuthBackendRoleSecretIDWrappedMarker)
+}
+
+func approleAuthBackendRoleSecretIDParseWrappedID(id string) (backend, role, wrappingAccessor string, err error) {
+	idx := strings.Index(id, approleAuthBackendRoleSecretIDWrappedMarker)
+	if idx < 0 {
+		return "", "", "", fmt.Errorf("id %q is not in the expected format auth/<backend>/role/<role>/secret-id/wrapped/<accessor>", id)
+	}
+	rolePath := id[:idx] + "/secret-id"
+	wrappingAccessor = id[idx+len(approleAuthBackendRoleSecretIDWrappedMarker):]
+
+	backend, err = approleAuthBackendRoleBackendFromPath(rolePath)
+	if err != nil {
+		return "", "", "", err
+	}
+	role, err = approleAuthBackendRoleNameFromPath(strings.TrimSuffix(rolePath, "/secret-id"))
+	if err != nil {
+		return "", "", "", err
+	}
+
+	return backend, role, wrappingAccessor, nil
+}
+
+func approleAuthBackendRoleSecretIDParseID(id string) (backend, role, accessor string, err error) {
+	lastSlash := strings.LastIndex(id, "/")
+	if lastSlash < 0 {
+		return "", "", "", fmt.Errorf("unexpected id %q", id)
+	}
+	rolePath := id[:lastSlash]
+	accessor = id[lastSlash+1:]
+
+	if !approleAuthBackendRoleSecretIDBackendFromPathRegex.MatchString(rolePath) ||
+		!approleAuthBackendRoleSecretIDRoleFromPathRegex.MatchString(rolePath) {
+		return "", "", "", fmt.Errorf("id %q is not in the expected format auth/<backend>/role/<role>/secret-id/<accessor>", id)
+	}
+
+	backendMatch := approleAuthBackendRoleSecretIDBackendFromPathRegex.FindStringSubmatch(rolePath)
+	roleMatch := approleAuthBackendRoleSecretIDRoleFromPathRegex.FindStringSubmatch(rolePath)
+
+	return backendMatch[1], roleMatch[1], accessor, nil
+}
@@ -0,0 +1,114 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/hashicorp/vault/api"
+	"github.com/terraform-providers/terraform-provider-vault/testutil"
+)
+
+func TestAccApproleAuthBackendRole_oldStyle(t *testing.T) {
+	backend := acctest.RandomWithPrefix("tf-test-approle-backend")
+	role := acctest.RandomWithPrefix("tf-test-approle-role")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testutil.TestAccPreCheck(t) },
+		Providers:    testProviders,
+		CheckDestroy: testAccCheckApproleAuthBackendRoleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccApproleAuthBackendRoleConfig_oldStyle(backend, role),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_approle_auth_backend_role.role", "backend", backend),
+					resource.TestCheckResourceAttr("vault_approle_auth_backend_role.role", "role_name", role),
+					resource.TestCheckResourceAttr("vault_approle_auth_backend_role.role", "policies.#", "2"),
+					resource.TestCheckResourceAttr("vault_approle_auth_backend_role.role", "bound_cidr_list.#", "1"),
+					resource.TestCheckResourceAttr("vault_approle_auth_backend_role.role", "period", "600"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccApproleAuthBackendRole_newStyle(t *testing.T) {
+	backend := acctest.RandomWithPrefix("tf-test-approle-backend")
+	role := acctest.RandomWithPrefix("tf-test-approle-role")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testutil.TestAccPreCheck(t) },
+		Providers:    testProviders,
+		CheckDestroy: testAccCheckApproleAuthBackendRoleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccApproleAuthBackendRoleConfig_newStyle(backend, role),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_approle_auth_backend_role.role", "backend", backend),
+					resource.TestCheckResourceAttr("vault_approle_auth_backend_role.role", "role_name", role),
+					resource.TestCheckResourceAttr("vault_approle_auth_backend_role.role", "token_policies.#", "2"),
+					resource.TestCheckResourceAttr("vault_approle_auth_backend_role.role", "token_bound_cidrs.#", "1"),
+					resource.TestCheckResourceAttr("vault_approle_auth_backend_role.role", "secret_id_bound_cidrs.#", "1"),
+					resource.TestCheckResourceAttr("vault_approle_auth_backend_role.role", "token_period", "600"),
+					resource.TestCheckResourceAttr("vault_approle_auth_backend_role.role", "token_type", "batch"),
+					resource.TestCheckResourceAttr("vault_approle_auth_backend_role.role", "local_secret_ids", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckApproleAuthBackendRoleDestroy(s *terraform.State) error {
+	client := testProvider.Meta().(*api.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "vault_approle_auth_backend_role" {
+			continue
+		}
+		secret, err := client.Logical().Read(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if secret != nil {
+			return fmt.Errorf("AppRole auth backend role %q still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccApproleAuthBackendRoleConfig_oldStyle(backend, role string) string {
+	return fmt.Sprintf(`
+resource "vault_auth_backend" "approle" {
+  type = "approle"
+  path = "%s"
+}
+
+resource "vault_approle_auth_backend_role" "role" {
+  backend         = vault_auth_backend.approle.path
+  role_name       = "%s"
+  policies        = ["default", "dev"]
+  bound_cidr_list = ["10.0.0.0/24"]
+  period          = 600
+}`, backend, role)
+}
+
+func testAccApproleAuthBackendRoleConfig_newStyle(backend, role string) string {
+	return fmt.Sprintf(`
+resource "vault_auth_backend" "approle" {
+  type = "approle"
+  path = "%s"
+}
+
+resource "vault_approle_auth_backend_role" "role" {
+  backend               = vault_auth_backend.approle.path
+  role_name             = "%s"
+  token_policies        = ["default", "dev"]
+  token_bound_cidrs     = ["10.0.0.0/24"]
+  secret_id_bound_cidrs = ["10.0.1.0/24"]
+  token_period          = 600
+  token_type            = "batch"
+  local_secret_ids      = true
+}`, backend, role)
+}
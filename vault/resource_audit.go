@@ -4,16 +4,20 @@ import (
 	"fmt"
 	"log"
 	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/vault/api"
 )
 
 func auditResource() *schema.Resource {
 	return &schema.Resource{
-		Create: auditWrite,
-		Delete: auditDelete,
-		Read:   auditRead,
+		Create:        auditWrite,
+		Update:        auditUpdate,
+		Delete:        auditDelete,
+		Read:          auditRead,
+		CustomizeDiff: auditCustomizeDiff,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -37,14 +41,12 @@ func auditResource() *schema.Resource {
 				Type:        schema.TypeString,
 				Optional:    true,
 				Required:    false,
-				ForceNew:    true,
 				Description: "Specifies a human-friendly description of the audit device",
 			},
 
 			"options": {
 				Type:        schema.TypeMap,
 				Optional:    true,
-				ForceNew:    true,
 				Description: "Specifies configuration options to pass to the audit device itself. This is dependent on the audit device type",
 			},
 
@@ -56,31 +58,100 @@ func auditResource() *schema.Resource {
 				ForceNew:    true,
 				Description: "Specifies if the audit device is a local only",
 			},
+
+			"disable_reload": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "If set, disables the in-place reload of `options`/`description` changes and falls back to destroying and recreating the audit device instead.",
+			},
 		},
 	}
 }
 
-func auditWrite(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*api.Client)
+// auditDeviceRequiredOptions lists the option keys that have no Vault-side
+// default for audit device types that aren't self-describing, so plan-time
+// validation can catch missing settings before Vault ever sees the request.
+// socket_type (socket) and facility/tag (syslog) are all defaulted by Vault
+// itself, so they're intentionally not required here.
+var auditDeviceRequiredOptions = map[string][]string{
+	"socket": {"address"},
+}
 
-	path := d.Get("path").(string)
+func auditCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	auditType := d.Get("type").(string)
+	if required, ok := auditDeviceRequiredOptions[auditType]; ok {
+		options, ok := d.Get("options").(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("error: options should be a map")
+		}
 
-	log.Printf("[DEBUG] Creating audit %s in Vault", path)
+		var missing []string
+		for _, key := range required {
+			if v, ok := options[key]; !ok || v.(string) == "" {
+				missing = append(missing, key)
+			}
+		}
+		if len(missing) > 0 {
+			return fmt.Errorf("options %s are required for audit devices of type %q", strings.Join(missing, ", "), auditType)
+		}
+	}
 
+	if d.Get("disable_reload").(bool) {
+		if d.HasChange("options") {
+			if err := d.ForceNew("options"); err != nil {
+				return err
+			}
+		}
+		if d.HasChange("description") {
+			if err := d.ForceNew("description"); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func auditOptionsFromResourceData(d *schema.ResourceData) (map[string]string, error) {
 	options := map[string]string{}
 	if v, ok := d.GetOk("options"); ok {
-		optionsI, ok := v.(map[string]interface{})
-		if !ok {
-			return fmt.Errorf("error: options should be a map")
+		converted, err := auditOptionsFromMap(v)
+		if err != nil {
+			return nil, err
 		}
-		for k, v := range optionsI {
-			if vs, ok := v.(string); ok {
-				options[k] = vs
-			} else {
-				return fmt.Errorf("error: options should be a string -> string map")
-			}
+		options = converted
+	}
+	return options, nil
+}
+
+func auditOptionsFromMap(v interface{}) (map[string]string, error) {
+	optionsI, ok := v.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("error: options should be a map")
+	}
+	options := map[string]string{}
+	for k, v := range optionsI {
+		if vs, ok := v.(string); ok {
+			options[k] = vs
+		} else {
+			return nil, fmt.Errorf("error: options should be a string -> string map")
 		}
 	}
+	return options, nil
+}
+
+func auditWrite(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Get("path").(string)
+
+	log.Printf("[DEBUG] Creating audit %s in Vault", path)
+
+	options, err := auditOptionsFromResourceData(d)
+	if err != nil {
+		return err
+	}
 
 	if err := client.Sys().EnableAudit(
 		path,
@@ -96,6 +167,66 @@ func auditWrite(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
+func auditUpdate(d *schema.ResourceData, meta interface{}) error {
+	if !d.HasChange("options") && !d.HasChange("description") {
+		// disable_reload is the only other updatable field, and it's purely
+		// local to Terraform: toggling it alone shouldn't tear down and
+		// recreate the audit device.
+		return nil
+	}
+
+	client := meta.(*api.Client)
+
+	path := d.Id()
+
+	options, err := auditOptionsFromResourceData(d)
+	if err != nil {
+		return err
+	}
+
+	// Keep the previous configuration around so we can try to restore it if
+	// re-enabling with the new configuration never succeeds; otherwise a
+	// transient failure would leave Vault with no audit sink at this path.
+	oldDescriptionRaw, _ := d.GetChange("description")
+	oldDescription := oldDescriptionRaw.(string)
+	oldOptionsRaw, _ := d.GetChange("options")
+	oldOptions, err := auditOptionsFromMap(oldOptionsRaw)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Disabling audit %s in Vault for reload", path)
+	if err := client.Sys().DisableAudit(path); err != nil {
+		return fmt.Errorf("error disabling audit %s in Vault: %s", path, err)
+	}
+
+	// The device is already disabled at this point, so only the re-enable
+	// needs retrying.
+	enableErr := resource.Retry(1*time.Minute, func() *resource.RetryError {
+		if err := client.Sys().EnableAudit(
+			path,
+			d.Get("type").(string),
+			d.Get("description").(string),
+			options,
+		); err != nil {
+			return resource.RetryableError(fmt.Errorf("error enabling audit %s in Vault: %s", path, err))
+		}
+
+		return nil
+	})
+	if enableErr != nil {
+		log.Printf("[WARN] Failed to reload audit %s with new configuration, attempting to restore previous configuration: %s", path, enableErr)
+		if restoreErr := client.Sys().EnableAudit(path, d.Get("type").(string), oldDescription, oldOptions); restoreErr != nil {
+			return fmt.Errorf("error enabling audit %s with new configuration: %s; additionally failed to restore its previous configuration, Vault has no audit device at %s: %s", path, enableErr, path, restoreErr)
+		}
+		return fmt.Errorf("error enabling audit %s with new configuration, restored previous configuration: %s", path, enableErr)
+	}
+
+	log.Printf("[DEBUG] Reloaded audit %s in Vault", path)
+
+	return auditRead(d, meta)
+}
+
 func auditDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*api.Client)
 